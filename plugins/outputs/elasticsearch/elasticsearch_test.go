@@ -228,6 +228,15 @@ func TestGetIndexName(t *testing.T) {
 			"indexname-%Y-%V",
 			"indexname-2014-49",
 		},
+		{
+			// %Y is the calendar year while %V is the ISO week, which for
+			// 2018-12-31 is week 1 of 2019.
+			time.Date(2018, 12, 31, 12, 00, 00, 00, time.UTC),
+			map[string]string{"tag1": "value1", "tag2": "value2"},
+			[]string{},
+			"indexname-%Y-%V",
+			"indexname-2018-1",
+		},
 		{
 			time.Date(2014, 12, 01, 23, 30, 00, 00, time.UTC),
 			map[string]string{"tag1": "value1", "tag2": "value2"},